@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renderinc/render-cli/pkg/client"
+	"github.com/renderinc/render-cli/pkg/environment"
+	"github.com/renderinc/render-cli/pkg/project"
+	"github.com/renderinc/render-cli/pkg/resource"
+	"github.com/renderinc/render-cli/pkg/tui"
+	"github.com/spf13/cobra"
+)
+
+var dashboardCmd = &cobra.Command{
+	Use:   "dashboard",
+	Short: "Browse services, environments, and projects in one tabbed view",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		model, err := newDashboardModel(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		_, err = tea.NewProgram(model).Run()
+		if closer, ok := model.(interface{ Close() }); ok {
+			closer.Close()
+		}
+		return err
+	},
+}
+
+// newDashboardModel builds the resource service and the environment/project
+// repos once and shares them across every tab, so switching tabs doesn't
+// reissue the API auth chain.
+//
+// Deploys and jobs aren't tabbed yet: there's no deploy- or job-listing
+// command in this CLI to lend a loader from, so adding those tabs now would
+// mean inventing that service layer here. They'll join once those commands
+// exist.
+func newDashboardModel(ctx context.Context) (tea.Model, error) {
+	resourceService, err := newResourceService()
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := http.DefaultClient
+	host := os.Getenv("RENDER_HOST")
+	apiKey := os.Getenv("RENDER_API_KEY")
+	c, err := client.ClientWithAuth(httpClient, host, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	environmentRepo := environment.NewRepo(c)
+	projectRepo := project.NewRepo(c)
+
+	return tui.NewTabbedModel(
+		tui.Tab{
+			Title: "Services",
+			New: func() tea.Model {
+				model, _ := renderResources(ctx, func(ListResourceInput) ([]resource.Resource, error) {
+					return resourceService.ListResources(ctx)
+				}, ListResourceInput{})
+				return model
+			},
+		},
+		tui.Tab{
+			Title: "Environments",
+			New: func() tea.Model {
+				return renderEnvironments(ctx, environmentRepo.List)
+			},
+		},
+		tui.Tab{
+			Title: "Projects",
+			New: func() tea.Model {
+				return renderProjects(ctx, projectRepo.List)
+			},
+		},
+	), nil
+}
+
+func renderEnvironments(ctx context.Context, list func(context.Context) ([]*client.Environment, error)) tea.Model {
+	columns := []table.Column{
+		{Title: "Name", Width: 30},
+		{Title: "Protected", Width: 12},
+		{Title: "ID", Width: 25},
+	}
+
+	return tui.NewTableModel[*client.Environment](
+		"environments",
+		tui.SinglePageLoader(list),
+		formatEnvironmentRow,
+		selectWorkspace[*client.Environment](ctx),
+		columns,
+	)
+}
+
+func formatEnvironmentRow(e *client.Environment) table.Row {
+	return []string{e.Name, e.ProtectedStatus, e.Id}
+}
+
+func renderProjects(ctx context.Context, list func(context.Context) ([]*client.Project, error)) tea.Model {
+	columns := []table.Column{
+		{Title: "Name", Width: 30},
+		{Title: "ID", Width: 25},
+	}
+
+	return tui.NewTableModel[*client.Project](
+		"projects",
+		tui.SinglePageLoader(list),
+		formatProjectRow,
+		selectWorkspace[*client.Project](ctx),
+		columns,
+	)
+}
+
+func formatProjectRow(p *client.Project) table.Row {
+	return []string{p.Name, p.Id}
+}
+
+// selectWorkspace is the select action for dashboard tabs that don't have a
+// richer command palette of their own yet: it jumps straight to workspace
+// selection, same as the "w" custom option on the Services tab.
+func selectWorkspace[T any](ctx context.Context) func(T) tea.Cmd {
+	return func(T) tea.Cmd {
+		return InteractiveWorkspace(ctx, ListWorkspaceInput{})
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(dashboardCmd)
+}
@@ -0,0 +1,10 @@
+package cmd
+
+import (
+	"github.com/renderinc/render-cli/pkg/command"
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&command.OutputFlag, "output", "o", "",
+		"output format: text, json, yaml, or csv (defaults to json when stdout isn't a terminal)")
+}
@@ -18,13 +18,55 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var repoFlag string
+
 var servicesCmd = &cobra.Command{
-	Use:   "services",
+	Use:   "services [owner/service-name]",
 	Short: "List and manage services",
+	Args:  cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		command.Wrap(cmd, loadResourceData, renderResources)(cmd.Context(), ListResourceInput{})
+		in := ListResourceInput{Repo: repoArg(args)}
+		command.Wrap(cmd, loadResourceData, renderResources)(cmd.Context(), in)
 		return nil
 	},
+	ValidArgsFunction: completeServiceNames,
+}
+
+// repoArg resolves the repo-scoped selector from either the --repo flag or
+// the optional positional argument, flag taking precedence.
+func repoArg(args []string) string {
+	if repoFlag != "" {
+		return repoFlag
+	}
+	if len(args) > 0 {
+		return args[0]
+	}
+	return ""
+}
+
+// completeServiceNames completes "owner/service-name" selectors, using each
+// resource's project name as the owner part, by querying the resource
+// service, for both the positional argument and --repo.
+func completeServiceNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	resourceService, err := newResourceService()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	resources, err := resourceService.ListResources(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var names []string
+	for _, r := range resources {
+		names = append(names, r.ProjectName()+"/"+r.Name())
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
 }
 
 func loadResourceData(ctx context.Context, _ ListResourceInput) ([]resource.Resource, error) {
@@ -35,10 +77,30 @@ func loadResourceData(ctx context.Context, _ ListResourceInput) ([]resource.Reso
 	return resourceService.ListResources(ctx)
 }
 
-type ListResourceInput struct{}
+type ListResourceInput struct {
+	// Repo is an optional "owner/service-name" (or bare service name)
+	// selector, set from the positional arg or --repo flag. It's only
+	// honored when command.FeatureRepoScopedServices is enabled.
+	Repo string
+}
 
 func (l ListResourceInput) String() []string {
-	return []string{}
+	if l.Repo == "" {
+		return []string{}
+	}
+	return []string{l.Repo}
+}
+
+// matchesRepo reports whether r is the resource named by repo, which may be
+// a bare service name or an "owner/service-name" pair.
+func matchesRepo(repo string) func(resource.Resource) bool {
+	name := repo
+	if _, svc, found := strings.Cut(repo, "/"); found {
+		name = svc
+	}
+	return func(r resource.Resource) bool {
+		return strings.EqualFold(r.Name(), name)
+	}
 }
 
 func renderResources(ctx context.Context, loadData func(input ListResourceInput) ([]resource.Resource, error), in ListResourceInput) (tea.Model, error) {
@@ -50,22 +112,26 @@ func renderResources(ctx context.Context, loadData func(input ListResourceInput)
 		{Title: "ID", Width: 25},
 	}
 
+	opts := []tui.TableModelOption[resource.Resource]{
+		tui.WithCustomOptions(tui.CustomOption[resource.Resource]{
+			Key:      "w",
+			Title:    "Change Workspace",
+			Function: resourceOptionSelectWorkspace(ctx),
+		}),
+	}
+	if command.Enabled(command.FeatureRepoScopedServices) && in.Repo != "" {
+		opts = append(opts, tui.WithAutoSelect(matchesRepo(in.Repo)))
+	}
+
 	return tui.NewTableModel[resource.Resource](
 		"resources",
-		func() ([]resource.Resource, error) {
+		tui.SinglePageLoader(func(_ context.Context) ([]resource.Resource, error) {
 			return loadData(in)
-		},
+		}),
 		formatResourceRow,
 		selectResource(ctx),
 		columns,
-		filterResource,
-		[]tui.CustomOption[resource.Resource]{
-			{
-				Key:      "w",
-				Title:    "Change Workspace",
-				Function: resourceOptionSelectWorkspace(ctx),
-			},
-		},
+		opts...,
 	), nil
 }
 
@@ -96,16 +162,6 @@ func selectResource(ctx context.Context) func(resource.Resource) tea.Cmd {
 	}
 }
 
-func filterResource(r resource.Resource, filter string) bool {
-	searchFields := []string{r.ID(), r.Name(), r.ProjectName(), r.EnvironmentName(), r.Type()}
-	for _, field := range searchFields {
-		if strings.Contains(strings.ToLower(field), filter) {
-			return true
-		}
-	}
-	return false
-}
-
 func newResourceService() (*resource.Service, error) {
 	httpClient := http.DefaultClient
 	host := os.Getenv("RENDER_HOST")
@@ -133,5 +189,7 @@ func resourceOptionSelectWorkspace(ctx context.Context) func(resource.Resource)
 }
 
 func init() {
+	servicesCmd.Flags().StringVar(&repoFlag, "repo", "", "owner/service-name to preselect and jump straight into its command palette (needs the repo-scoped-services feature)")
+	_ = servicesCmd.RegisterFlagCompletionFunc("repo", completeServiceNames)
 	rootCmd.AddCommand(servicesCmd)
 }
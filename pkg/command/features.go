@@ -0,0 +1,43 @@
+package command
+
+import (
+	"os"
+	"strings"
+)
+
+// Feature names a togglable, experimental CLI behavior that hasn't
+// graduated to always-on yet.
+type Feature string
+
+const (
+	// FeatureRepoScopedServices lets `services` accept an "owner/name"
+	// positional argument (or --repo flag) that preselects a resource and
+	// jumps straight into its command palette.
+	FeatureRepoScopedServices Feature = "repo-scoped-services"
+)
+
+// enabledFeatures is seeded once from the RENDER_CLI_FEATURES env var
+// (a comma-separated list of feature names) and can be extended at runtime
+// via Enable.
+var enabledFeatures = featuresFromEnv()
+
+func featuresFromEnv() map[Feature]bool {
+	enabled := map[Feature]bool{}
+	for _, name := range strings.Split(os.Getenv("RENDER_CLI_FEATURES"), ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			enabled[Feature(name)] = true
+		}
+	}
+	return enabled
+}
+
+// Enabled reports whether feature has been turned on.
+func Enabled(feature Feature) bool {
+	return enabledFeatures[feature]
+}
+
+// Enable turns feature on for the lifetime of the process.
+func Enable(feature Feature) {
+	enabledFeatures[feature] = true
+}
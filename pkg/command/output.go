@@ -0,0 +1,25 @@
+package command
+
+import (
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/renderinc/render-cli/pkg/text"
+)
+
+// OutputFlag backs the global --output/-o flag, bound by rootCmd.
+var OutputFlag string
+
+// ResolveFormat returns the text.Format commands should render with: the
+// explicit --output value if one was set, otherwise FormatJSON when stdout
+// isn't a terminal (so piping the CLI is scriptable by default without
+// passing --output), otherwise FormatText for the interactive case.
+func ResolveFormat() text.Format {
+	if OutputFlag != "" {
+		return text.Format(OutputFlag)
+	}
+	if !isatty.IsTerminal(os.Stdout.Fd()) {
+		return text.FormatJSON
+	}
+	return text.FormatText
+}
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/render-cli/pkg/client"
+	clientjob "github.com/renderinc/render-cli/pkg/client/jobs"
+	"github.com/renderinc/render-cli/pkg/resource"
+	"github.com/renderinc/render-cli/pkg/text"
+)
+
+// Wrap adapts a command's data loader and interactive tea.Model builder into
+// the single step cobra's RunE calls: on a terminal (and no --output
+// override) it launches the bubbletea program, otherwise it loads the data
+// once and prints it with the Formatter ResolveFormat selects, so the
+// command stays scriptable when piped or given --output.
+func Wrap[In any, Out any](
+	cmd *cobra.Command,
+	loadData func(ctx context.Context, in In) ([]Out, error),
+	render func(ctx context.Context, loadData func(In) ([]Out, error), in In) (tea.Model, error),
+) func(ctx context.Context, in In) {
+	return func(ctx context.Context, in In) {
+		if format := ResolveFormat(); OutputFlag != "" || format != text.FormatText {
+			runFormatted(ctx, cmd, format, loadData, in)
+			return
+		}
+		runInteractive(ctx, cmd, loadData, render, in)
+	}
+}
+
+func runInteractive[In any, Out any](
+	ctx context.Context,
+	cmd *cobra.Command,
+	loadData func(ctx context.Context, in In) ([]Out, error),
+	render func(ctx context.Context, loadData func(In) ([]Out, error), in In) (tea.Model, error),
+	in In,
+) {
+	model, err := render(ctx, func(in In) ([]Out, error) { return loadData(ctx, in) }, in)
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err)
+		return
+	}
+
+	_, err = tea.NewProgram(model).Run()
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err)
+	}
+
+	if closer, ok := model.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
+func runFormatted[In any, Out any](
+	ctx context.Context,
+	cmd *cobra.Command,
+	format text.Format,
+	loadData func(ctx context.Context, in In) ([]Out, error),
+	in In,
+) {
+	items, err := loadData(ctx, in)
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err)
+		return
+	}
+
+	out, err := formatItems(text.NewFormatter(format), items)
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err)
+		return
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), out)
+}
+
+// formatItems dispatches to the Formatter method matching items' concrete
+// type. Wrap is generic over every command's output type, but Formatter
+// still has one method per type since each has its own columns.
+func formatItems[Out any](f text.Formatter, items []Out) (string, error) {
+	switch v := any(items).(type) {
+	case []resource.Resource:
+		return f.Resources(v)
+	case []*clientjob.Job:
+		return f.Jobs(v)
+	case []*client.Deploy:
+		return f.Deploys(v)
+	case []*client.Project:
+		return f.Projects(v)
+	case []*client.Environment:
+		return f.Environments(v)
+	default:
+		return "", fmt.Errorf("command: no formatter registered for %T", items)
+	}
+}
@@ -0,0 +1,54 @@
+package text
+
+import (
+	"encoding/csv"
+	"strings"
+
+	"github.com/renderinc/render-cli/pkg/client"
+	clientjob "github.com/renderinc/render-cli/pkg/client/jobs"
+	"github.com/renderinc/render-cli/pkg/resource"
+)
+
+// csvFormatter renders the same header/row columns as textFormatter, just
+// as CSV instead of an ASCII table.
+type csvFormatter struct{}
+
+func (csvFormatter) Resources(v []resource.Resource) (string, error) {
+	return renderCSV(resourceHeader(), resourceRows(v))
+}
+
+func (csvFormatter) Jobs(v []*clientjob.Job) (string, error) {
+	return renderCSV(jobHeader(), jobRows(v))
+}
+
+func (csvFormatter) Deploys(v []*client.Deploy) (string, error) {
+	return renderCSV(deployHeader(), deployRows(v))
+}
+
+func (csvFormatter) Projects(v []*client.Project) (string, error) {
+	return renderCSV(projectHeader(), projectRows(v))
+}
+
+func (csvFormatter) Environments(v []*client.Environment) (string, error) {
+	return renderCSV(environmentHeader(), environmentRows(v))
+}
+
+func renderCSV(header []string, rows [][]string) (string, error) {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,44 @@
+package text
+
+import (
+	"github.com/renderinc/render-cli/pkg/client"
+	clientjob "github.com/renderinc/render-cli/pkg/client/jobs"
+	"github.com/renderinc/render-cli/pkg/resource"
+)
+
+// Formatter renders a loaded resource set as a string in one specific
+// output format. Implementations exist per format (text, json, yaml, csv);
+// there's one method per resource type because each has its own columns.
+type Formatter interface {
+	Resources(v []resource.Resource) (string, error)
+	Jobs(v []*clientjob.Job) (string, error)
+	Deploys(v []*client.Deploy) (string, error)
+	Projects(v []*client.Project) (string, error)
+	Environments(v []*client.Environment) (string, error)
+}
+
+// Format names a Formatter implementation, selected via the global
+// --output/-o flag.
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatCSV  Format = "csv"
+)
+
+// NewFormatter resolves format to its Formatter, falling back to FormatText
+// for anything unrecognized.
+func NewFormatter(format Format) Formatter {
+	switch format {
+	case FormatJSON:
+		return jsonFormatter{}
+	case FormatYAML:
+		return yamlFormatter{}
+	case FormatCSV:
+		return csvFormatter{}
+	default:
+		return textFormatter{}
+	}
+}
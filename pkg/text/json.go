@@ -0,0 +1,30 @@
+package text
+
+import (
+	"encoding/json"
+
+	"github.com/renderinc/render-cli/pkg/client"
+	clientjob "github.com/renderinc/render-cli/pkg/client/jobs"
+	"github.com/renderinc/render-cli/pkg/resource"
+)
+
+// jsonFormatter marshals the full resource structs, not just the columns a
+// text table would show, so scripted consumers (e.g. piping to jq) get
+// everything the API returned.
+type jsonFormatter struct{}
+
+func (jsonFormatter) Resources(v []resource.Resource) (string, error) { return marshalJSON(v) }
+func (jsonFormatter) Jobs(v []*clientjob.Job) (string, error)         { return marshalJSON(v) }
+func (jsonFormatter) Deploys(v []*client.Deploy) (string, error)      { return marshalJSON(v) }
+func (jsonFormatter) Projects(v []*client.Project) (string, error)    { return marshalJSON(v) }
+func (jsonFormatter) Environments(v []*client.Environment) (string, error) {
+	return marshalJSON(v)
+}
+
+func marshalJSON(v any) (string, error) {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
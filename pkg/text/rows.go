@@ -0,0 +1,61 @@
+package text
+
+import (
+	"github.com/renderinc/render-cli/pkg/client"
+	clientjob "github.com/renderinc/render-cli/pkg/client/jobs"
+	"github.com/renderinc/render-cli/pkg/deploy"
+	"github.com/renderinc/render-cli/pkg/resource"
+)
+
+// Each *Header/*Rows pair backs both the text table and the CSV formatter,
+// so the two stay in sync without duplicating column layout.
+
+func resourceHeader() []string { return []string{"Name", "Project", "Environment", "Type", "ID"} }
+
+func resourceRows(v []resource.Resource) [][]string {
+	rows := make([][]string, len(v))
+	for i, r := range v {
+		rows[i] = []string{r.Name(), r.ProjectName(), r.EnvironmentName(), r.Type(), r.ID()}
+	}
+	return rows
+}
+
+func jobHeader() []string { return []string{"Command", "Started", "Finished", "Plan", "ID"} }
+
+func jobRows(v []*clientjob.Job) [][]string {
+	rows := make([][]string, len(v))
+	for i, r := range v {
+		rows[i] = []string{r.StartCommand, r.StartedAt, r.FinishedAt, r.PlanId, r.Id}
+	}
+	return rows
+}
+
+func deployHeader() []string { return deploy.Header() }
+
+func deployRows(v []*client.Deploy) [][]string {
+	rows := make([][]string, len(v))
+	for i, r := range v {
+		rows[i] = deploy.Row(r)
+	}
+	return rows
+}
+
+func projectHeader() []string { return []string{"Name", "ID"} }
+
+func projectRows(v []*client.Project) [][]string {
+	rows := make([][]string, len(v))
+	for i, r := range v {
+		rows[i] = []string{r.Name, r.Id}
+	}
+	return rows
+}
+
+func environmentHeader() []string { return []string{"Name", "Protected", "ID"} }
+
+func environmentRows(v []*client.Environment) [][]string {
+	rows := make([][]string, len(v))
+	for i, r := range v {
+		rows[i] = []string{r.Name, r.ProtectedStatus, r.Id}
+	}
+	return rows
+}
@@ -3,53 +3,70 @@ package text
 import (
 	"github.com/jedib0t/go-pretty/table"
 
-	"github.com/renderinc/cli/pkg/client"
-	clientjob "github.com/renderinc/cli/pkg/client/jobs"
-	"github.com/renderinc/cli/pkg/deploy"
-	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/render-cli/pkg/client"
+	clientjob "github.com/renderinc/render-cli/pkg/client/jobs"
+	"github.com/renderinc/render-cli/pkg/resource"
 )
 
+// textFormatter renders resources as the interactive-style ASCII tables
+// this CLI has always printed for TTY stdout.
+type textFormatter struct{}
+
+func (textFormatter) Resources(v []resource.Resource) (string, error) {
+	return renderTable(resourceHeader(), resourceRows(v)), nil
+}
+
+func (textFormatter) Jobs(v []*clientjob.Job) (string, error) {
+	return renderTable(jobHeader(), jobRows(v)), nil
+}
+
+func (textFormatter) Deploys(v []*client.Deploy) (string, error) {
+	return renderTable(deployHeader(), deployRows(v)), nil
+}
+
+func (textFormatter) Projects(v []*client.Project) (string, error) {
+	return renderTable(projectHeader(), projectRows(v)), nil
+}
+
+func (textFormatter) Environments(v []*client.Environment) (string, error) {
+	return renderTable(environmentHeader(), environmentRows(v)), nil
+}
+
+// ResourceTable, JobTable, DeployTable, ProjectTable, and EnvironmentTable
+// render a plain ASCII table directly, independent of the --output flag.
+// They predate NewFormatter and stay around for callers that want that
+// rendering specifically rather than going through the resolved format.
+
 func ResourceTable(v []resource.Resource) string {
-	t := table.NewWriter()
-	t.AppendHeader(table.Row{"Name", "Project", "Environment", "Type", "ID"})
-	for _, r := range v {
-		t.AppendRow(table.Row{r.Name(), r.ProjectName(), r.EnvironmentName(), r.Type(), r.ID()})
-	}
-	return FormatString(t.Render())
+	s, _ := (textFormatter{}).Resources(v)
+	return s
 }
 
 func JobTable(v []*clientjob.Job) string {
-	t := table.NewWriter()
-	t.AppendHeader(table.Row{"Command", "Started", "Finished", "Plan", "ID"})
-	for _, r := range v {
-		t.AppendRow(table.Row{r.StartCommand, r.StartedAt, r.FinishedAt, r.PlanId, r.Id})
-	}
-	return FormatString(t.Render())
+	s, _ := (textFormatter{}).Jobs(v)
+	return s
 }
 
 func DeployTable(v []*client.Deploy) string {
-	t := table.NewWriter()
-	t.AppendHeader(toRow(deploy.Header()))
-	for _, r := range v {
-		t.AppendRow(toRow(deploy.Row(r)))
-	}
-	return FormatString(t.Render())
+	s, _ := (textFormatter{}).Deploys(v)
+	return s
 }
 
 func ProjectTable(v []*client.Project) string {
-	t := table.NewWriter()
-	t.AppendHeader(table.Row{"Name", "ID"})
-	for _, r := range v {
-		t.AppendRow(table.Row{r.Name, r.Id})
-	}
-	return FormatString(t.Render())
+	s, _ := (textFormatter{}).Projects(v)
+	return s
 }
 
 func EnvironmentTable(v []*client.Environment) string {
+	s, _ := (textFormatter{}).Environments(v)
+	return s
+}
+
+func renderTable(header []string, rows [][]string) string {
 	t := table.NewWriter()
-	t.AppendHeader(table.Row{"Name", "Protected", "ID"})
-	for _, r := range v {
-		t.AppendRow(table.Row{r.Name, r.ProtectedStatus, r.Id})
+	t.AppendHeader(toRow(header))
+	for _, r := range rows {
+		t.AppendRow(toRow(r))
 	}
 	return FormatString(t.Render())
 }
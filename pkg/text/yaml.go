@@ -0,0 +1,28 @@
+package text
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/renderinc/render-cli/pkg/client"
+	clientjob "github.com/renderinc/render-cli/pkg/client/jobs"
+	"github.com/renderinc/render-cli/pkg/resource"
+)
+
+// yamlFormatter marshals the full resource structs as YAML.
+type yamlFormatter struct{}
+
+func (yamlFormatter) Resources(v []resource.Resource) (string, error) { return marshalYAML(v) }
+func (yamlFormatter) Jobs(v []*clientjob.Job) (string, error)         { return marshalYAML(v) }
+func (yamlFormatter) Deploys(v []*client.Deploy) (string, error)      { return marshalYAML(v) }
+func (yamlFormatter) Projects(v []*client.Project) (string, error)    { return marshalYAML(v) }
+func (yamlFormatter) Environments(v []*client.Environment) (string, error) {
+	return marshalYAML(v)
+}
+
+func marshalYAML(v any) (string, error) {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CustomOption is an additional row-scoped action surfaced in the table's
+// help footer and bound to a key, e.g. "w" to change workspace for the
+// selected resource.
+type CustomOption[T any] struct {
+	Key      string
+	Title    string
+	Function func(T) tea.Cmd
+}
+
+// WithCustomOptions registers extra key-bound actions that operate on
+// whichever row is currently selected.
+func WithCustomOptions[T any](options ...CustomOption[T]) TableModelOption[T] {
+	return func(m *TableModel[T]) {
+		m.customOptions = append(m.customOptions, options...)
+	}
+}
+
+// customOptionCmd runs the custom option bound to key against the currently
+// selected row, if any is bound.
+func (m *TableModel[T]) customOptionCmd(key string) tea.Cmd {
+	for _, opt := range m.customOptions {
+		if opt.Key != key {
+			continue
+		}
+		if datum, ok := m.currentRow(); ok {
+			return opt.Function(datum)
+		}
+		return nil
+	}
+	return nil
+}
+
+// tableHelp adapts a TableModel's KeyMap plus its registered CustomOptions
+// into one help.KeyMap, so the full help shows the real affordances a table
+// responds to (e.g. "w Change Workspace") instead of a fixed binding that
+// doesn't exist. ShortHelp is KeyMap's own and lists no custom options.
+type tableHelp[T any] struct {
+	KeyMap
+	customOptions []CustomOption[T]
+}
+
+// FullHelp implements help.KeyMap, inserting a group of the registered
+// custom options just before Help.
+func (h tableHelp[T]) FullHelp() [][]key.Binding {
+	groups := h.KeyMap.FullHelp()
+	if len(h.customOptions) == 0 {
+		return groups
+	}
+
+	custom := make([]key.Binding, len(h.customOptions))
+	for i, opt := range h.customOptions {
+		custom[i] = key.NewBinding(key.WithKeys(opt.Key), key.WithHelp(opt.Key, opt.Title))
+	}
+
+	last := len(groups) - 1
+	out := make([][]key.Binding, 0, len(groups)+1)
+	out = append(out, groups[:last]...)
+	out = append(out, custom)
+	return append(out, groups[last])
+}
+
+var _ help.KeyMap = tableHelp[struct{}]{}
@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+// FuzzyMatch pairs a matched item with the rune offsets, into its label,
+// that the query matched. TableModel uses the offsets to highlight the
+// matched runes when rendering the row.
+type FuzzyMatch[T any] struct {
+	Item    T
+	Indexes []int
+}
+
+// FuzzyMatcher scores and ranks items against a search query. TableModel
+// defaults to defaultFuzzyMatcher; pass a custom one via WithFuzzyMatcher to
+// change the scoring, or wrap an existing predicate with SubstringMatcher to
+// restore the original case-insensitive substring behavior.
+type FuzzyMatcher[T any] func(items []T, query string, label func(T) string) []FuzzyMatch[T]
+
+func defaultFuzzyMatcher[T any](items []T, query string, label func(T) string) []FuzzyMatch[T] {
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = label(item)
+	}
+
+	ranked := fuzzy.Find(query, labels)
+	matches := make([]FuzzyMatch[T], len(ranked))
+	for i, r := range ranked {
+		matches[i] = FuzzyMatch[T]{Item: items[r.Index], Indexes: r.MatchedIndexes}
+	}
+	return matches
+}
+
+// SubstringMatcher adapts a case-insensitive substring predicate (the kind
+// TableModel used before fuzzy matching) into a FuzzyMatcher, for callers
+// that want to opt out of fuzzy ranking.
+func SubstringMatcher[T any](filterFunc func(T, string) bool) FuzzyMatcher[T] {
+	return func(items []T, query string, _ func(T) string) []FuzzyMatch[T] {
+		query = strings.ToLower(query)
+		var matches []FuzzyMatch[T]
+		for _, item := range items {
+			if filterFunc(item, query) {
+				matches = append(matches, FuzzyMatch[T]{Item: item})
+			}
+		}
+		return matches
+	}
+}
+
+var highlightStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true)
+
+// highlightRow re-renders row with the runes at indexes (offsets into the
+// row's cells, joined with a single space as TableModel's default label
+// does) styled to call out the match.
+func highlightRow(row table.Row, indexes []int) table.Row {
+	if len(indexes) == 0 {
+		return row
+	}
+
+	marked := make(map[int]bool, len(indexes))
+	for _, i := range indexes {
+		marked[i] = true
+	}
+
+	highlighted := make(table.Row, len(row))
+	offset := 0
+	for i, cell := range row {
+		runes := []rune(cell)
+		highlighted[i] = highlightCell(runes, marked, offset)
+		offset += len(runes) + 1 // +1 for the separator the default label joins cells with
+	}
+	return highlighted
+}
+
+func highlightCell(runes []rune, marked map[int]bool, offset int) string {
+	var b strings.Builder
+	for i, r := range runes {
+		if marked[offset+i] {
+			b.WriteString(highlightStyle.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,53 @@
+package tui
+
+import "github.com/charmbracelet/bubbles/key"
+
+// KeyMap defines the key bindings TableModel responds to. Construct one
+// with DefaultKeyMap and override individual bindings (e.g. from a viper
+// config file) before passing it to WithKeyMap, so the visible help footer
+// always matches what the handlers actually do. Per-row CustomOption
+// bindings aren't part of KeyMap since they're registered (and bound to
+// their own keys) per table via WithCustomOptions; see tableHelp.
+type KeyMap struct {
+	Search   key.Binding
+	Select   key.Binding
+	Back     key.Binding
+	Refresh  key.Binding
+	NextPage key.Binding
+	PrevPage key.Binding
+	Help     key.Binding
+}
+
+// DefaultKeyMap returns TableModel's built-in bindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Search:   key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search")),
+		Select:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		Back:     key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+		Refresh:  key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
+		NextPage: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		PrevPage: key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		Help:     key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "toggle help")),
+	}
+}
+
+// ShortHelp implements help.KeyMap.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Search, k.Select, k.Back, k.Help}
+}
+
+// FullHelp implements help.KeyMap.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Search, k.Select, k.Back},
+		{k.Refresh, k.NextPage, k.PrevPage},
+		{k.Help},
+	}
+}
+
+// WithKeyMap overrides the default key bindings TableModel responds to.
+func WithKeyMap[T any](keyMap KeyMap) TableModelOption[T] {
+	return func(m *TableModel[T]) {
+		m.keyMap = keyMap
+	}
+}
@@ -0,0 +1,39 @@
+package tui
+
+import "context"
+
+// Page is one batch of items a PageLoader has fetched so far.
+type Page[T any] struct {
+	Items []T
+	// Total is the loader's best estimate of how many items it expects to
+	// load overall. 0 means unknown.
+	Total int
+	// Err, if set, aborts the load; TableModel surfaces it the same way a
+	// failed single-shot load always has.
+	Err error
+}
+
+// PageLoader streams data in pages instead of blocking until everything is
+// fetched, so TableModel can render the first page as soon as it arrives
+// and append later ones as they come in. It must stop sending pages and
+// return promptly once ctx is done.
+type PageLoader[T any] func(ctx context.Context, pages chan<- Page[T]) error
+
+// SinglePageLoader adapts a classic "fetch everything, then return" loader
+// into a PageLoader that emits one page, for callers that don't need true
+// pagination.
+func SinglePageLoader[T any](load func(ctx context.Context) ([]T, error)) PageLoader[T] {
+	return func(ctx context.Context, pages chan<- Page[T]) error {
+		items, err := load(ctx)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case pages <- Page[T]{Items: items, Total: len(items)}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		return nil
+	}
+}
@@ -1,21 +1,30 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/fsnotify/fsnotify"
 )
 
 type TableModel[T any] struct {
 	name          string
 	loading       bool
+	streaming     bool
+	loaded        int
+	total         int
 	data          []T
+	previousData  []T
 	filteredData  []T
+	rowHighlights [][]int
 	table         table.Model
 	spinner       spinner.Model
 	searchInput   textinput.Model
@@ -24,39 +33,88 @@ type TableModel[T any] struct {
 
 	columns    []table.Column
 	formatFunc func(T) table.Row
-	loadFunc   func() ([]T, error)
+	loadFunc   PageLoader[T]
+	pages      <-chan Page[T]
+	generation int
+	cancelLoad context.CancelFunc
+	loadErr    error
 	selectFunc func(T) tea.Cmd
-	filterFunc func(T, string) bool
+	matcher    FuzzyMatcher[T]
+
+	watchPaths []string
+	watcher    *fsnotify.Watcher
+	lastDiff   rowDiff
+
+	keyMap        KeyMap
+	help          help.Model
+	customOptions []CustomOption[T]
+	autoSelect    func(T) bool
 
 	actionStyle lipgloss.Style
 }
 
+// WithAutoSelect runs selectFunc against the single loaded item matching
+// predicate each time a load finishes, including reloads. If zero or more
+// than one item matches, nothing is selected. Useful for preselecting a row
+// from a CLI argument (e.g. a repo-scoped invocation).
+func WithAutoSelect[T any](predicate func(T) bool) TableModelOption[T] {
+	return func(m *TableModel[T]) {
+		m.autoSelect = predicate
+	}
+}
+
+// TableModelOption configures optional TableModel behavior, set via
+// NewTableModel's variadic opts.
+type TableModelOption[T any] func(*TableModel[T])
+
+// WithFuzzyMatcher overrides the scoring/ranking TableModel uses when
+// searching. Pass SubstringMatcher(yourOldFilterFunc) to preserve
+// case-insensitive substring behavior, or a custom FuzzyMatcher to plug in a
+// different scorer.
+func WithFuzzyMatcher[T any](matcher FuzzyMatcher[T]) TableModelOption[T] {
+	return func(m *TableModel[T]) {
+		m.matcher = matcher
+	}
+}
+
 func NewTableModel[T any](
 	name string,
-	loadFunc func() ([]T, error),
+	loadFunc PageLoader[T],
 	formatFunc func(T) table.Row,
 	selectFunc func(T) tea.Cmd,
 	columns []table.Column,
-	filterFunc func(T, string) bool,
+	opts ...TableModelOption[T],
 ) *TableModel[T] {
 	m := &TableModel[T]{
 		name:        name,
 		formatFunc:  formatFunc,
 		loadFunc:    loadFunc,
 		selectFunc:  selectFunc,
-		filterFunc:  filterFunc,
+		matcher:     defaultFuzzyMatcher[T],
 		columns:     columns,
 		loading:     true,
+		keyMap:      DefaultKeyMap(),
 		actionStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("241")),
 	}
 
+	for _, opt := range opts {
+		opt(m)
+	}
+
 	m.initSpinner()
 	m.initSearchInput()
 	m.initTable()
+	m.initHelp()
 
 	return m
 }
 
+// label is the text a search query is matched against. It joins the
+// rendered row so results read and highlight the same way they're displayed.
+func (m *TableModel[T]) label(item T) string {
+	return strings.Join(m.formatFunc(item), " ")
+}
+
 func (m *TableModel[T]) initSpinner() {
 	m.spinner = spinner.New()
 	m.spinner.Spinner = spinner.Dot
@@ -89,44 +147,163 @@ func (m *TableModel[T]) initTable() {
 	m.table.SetStyles(s)
 }
 
+func (m *TableModel[T]) initHelp() {
+	m.help = help.New()
+}
+
 func (m *TableModel[T]) Init() tea.Cmd {
-	return tea.Batch(m.spinner.Tick, m.loadData)
+	return tea.Batch(m.spinner.Tick, m.reload(), m.startWatching())
 }
 
-func (m *TableModel[T]) loadData() tea.Msg {
-	data, err := m.loadFunc()
-	if err != nil {
-		return loadedErrMsg(err)
+// Close releases the table's file watcher, if WithWatchPaths started one,
+// and cancels any in-flight load. bubbletea has no teardown hook of its own,
+// so callers that run a TableModel (directly, or nested in a TabbedModel)
+// via tea.NewProgram should call this once Run returns.
+func (m *TableModel[T]) Close() {
+	if m.cancelLoad != nil {
+		m.cancelLoad()
+	}
+	if m.watcher != nil {
+		_ = m.watcher.Close()
 	}
-	return loadDataMsg[T](data)
 }
 
-type loadDataMsg[T any] []T
-type loadedErrMsg error
+// reload (re)starts loading from scratch: any in-flight load is cancelled,
+// the current data is kept aside for a diff against the reloaded set (see
+// rowDiff), and a fresh, cancellable PageLoader run begins.
+//
+// The cancelled load's goroutine still closes its pages channel and sends a
+// final pagesDoneMsg once reload cancels it, same as a normal completion.
+// generation tags every page/done message with the load that produced it, so
+// Update can tell a stale signal from the cancelled load apart from the new
+// one and ignore it instead of double-finishing.
+func (m *TableModel[T]) reload() tea.Cmd {
+	if m.cancelLoad != nil {
+		m.cancelLoad()
+	}
+
+	m.generation++
+	gen := m.generation
+
+	m.previousData = m.data
+	m.data = nil
+	m.filteredData = nil
+	m.rowHighlights = nil
+	m.loaded = 0
+	m.total = 0
+	m.loadErr = nil
+	m.loading = true
+	m.streaming = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelLoad = cancel
+
+	pages := make(chan Page[T])
+	m.pages = pages
+	go func() {
+		defer close(pages)
+		if err := m.loadFunc(ctx, pages); err != nil && ctx.Err() == nil {
+			select {
+			case pages <- Page[T]{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return waitForPage(pages, gen)
+}
+
+// cancelReload stops an in-flight reload (e.g. on esc) without treating it
+// as an error; whatever pages already arrived stay on screen.
+func (m *TableModel[T]) cancelReload() {
+	if m.cancelLoad != nil {
+		m.cancelLoad()
+	}
+	m.streaming = false
+	m.loading = false
+}
+
+// waitForPage waits on the specific pages channel and generation a reload
+// started, so the tea.Cmd it returns stays tied to that load even if a later
+// reload replaces m.pages before this one finishes.
+func waitForPage[T any](pages <-chan Page[T], gen int) tea.Cmd {
+	return func() tea.Msg {
+		page, ok := <-pages
+		if !ok {
+			return pagesDoneMsg{gen: gen}
+		}
+		return pageMsg[T]{gen: gen, page: page}
+	}
+}
+
+type pageMsg[T any] struct {
+	gen  int
+	page Page[T]
+}
+type pagesDoneMsg struct{ gen int }
+
+func (m *TableModel[T]) appendPage(page Page[T]) {
+	m.data = append(m.data, page.Items...)
+	m.loaded = len(m.data)
+	if page.Total > 0 {
+		m.total = page.Total
+	}
 
-func (m *TableModel[T]) setTableData(msg loadDataMsg[T]) {
-	m.data = msg
-	m.filteredData = msg
+	if m.currentFilter != "" {
+		m.filteredData, m.rowHighlights = m.filterData(m.currentFilter)
+	} else {
+		m.filteredData = m.data
+		m.rowHighlights = nil
+	}
 	m.updateTableRows()
 	m.loading = false
 }
 
+func (m *TableModel[T]) finishLoad() {
+	m.streaming = false
+	m.loading = false
+	if m.previousData != nil {
+		m.lastDiff = diffRows(m.previousData, m.data, m.label)
+		m.previousData = nil
+	}
+}
+
 func (m *TableModel[T]) updateTableRows() {
 	rows := make([]table.Row, len(m.filteredData))
 	for i, d := range m.filteredData {
-		rows[i] = m.formatFunc(d)
+		row := m.formatFunc(d)
+		if i < len(m.rowHighlights) {
+			row = highlightRow(row, m.rowHighlights[i])
+		}
+		rows[i] = row
 	}
 	m.table.SetRows(rows)
 }
 
 func (m *TableModel[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
-	case loadDataMsg[T]:
-		m.setTableData(msg)
+	case pageMsg[T]:
+		if msg.gen != m.generation {
+			return m, nil
+		}
+		if msg.page.Err != nil {
+			m.loadErr = msg.page.Err
+			m.loading = false
+			m.streaming = false
+			return m, nil
+		}
+		m.appendPage(msg.page)
+		return m, waitForPage(m.pages, msg.gen)
+	case pagesDoneMsg:
+		if msg.gen != m.generation {
+			return m, nil
+		}
+		m.finishLoad()
+		return m, m.autoSelectCmd()
+	case fileChangedMsg:
+		return m, tea.Batch(m.reload(), m.waitForFileChange)
+	case watchErrMsg:
 		return m, nil
-	case loadedErrMsg:
-		m.loading = false
-		return m, tea.Quit
 	case tea.KeyMsg:
 		if m.searching {
 			return m.updateSearching(msg)
@@ -141,16 +318,16 @@ func (m *TableModel[T]) updateSearching(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	m.searchInput, cmd = m.searchInput.Update(msg)
 
-	switch msg.String() {
-	case "enter":
+	switch {
+	case key.Matches(msg, m.keyMap.Select):
 		return m.handleEnter()
-	case "esc":
+	case key.Matches(msg, m.keyMap.Back):
 		return m.handleEsc()
-	case "up", "down":
+	case key.Matches(msg, m.keyMap.NextPage, m.keyMap.PrevPage):
 		m.table, cmd = m.table.Update(msg)
 	default:
 		m.currentFilter = m.searchInput.Value()
-		m.filteredData = m.filterData(m.currentFilter)
+		m.filteredData, m.rowHighlights = m.filterData(m.currentFilter)
 		m.updateTableRows()
 	}
 
@@ -158,18 +335,26 @@ func (m *TableModel[T]) updateSearching(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (m *TableModel[T]) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	switch {
+	case key.Matches(msg, m.keyMap.Back):
 		return m.handleEsc()
-	case "/":
+	case key.Matches(msg, m.keyMap.Search):
 		return m.handleSlash()
-	case "enter":
+	case key.Matches(msg, m.keyMap.Select):
 		return m.handleEnter()
-	case "up", "down":
+	case key.Matches(msg, m.keyMap.Refresh):
+		return m, m.reload()
+	case key.Matches(msg, m.keyMap.Help):
+		m.help.ShowAll = !m.help.ShowAll
+		return m, nil
+	case key.Matches(msg, m.keyMap.NextPage, m.keyMap.PrevPage):
 		var cmd tea.Cmd
 		m.table, cmd = m.table.Update(msg)
 		return m, cmd
 	}
+	if cmd := m.customOptionCmd(msg.String()); cmd != nil {
+		return m, cmd
+	}
 	return m, nil
 }
 
@@ -179,7 +364,10 @@ func (m *TableModel[T]) handleEsc() (tea.Model, tea.Cmd) {
 		m.searchInput.Blur()
 		m.currentFilter = ""
 		m.filteredData = m.data
+		m.rowHighlights = nil
 		m.updateTableRows()
+	} else if m.streaming {
+		m.cancelReload()
 	} else if m.table.Focused() {
 		m.table.Blur()
 	} else {
@@ -215,31 +403,68 @@ func (m *TableModel[T]) updateComponents(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
-func (m *TableModel[T]) selectCurrentRow() tea.Cmd {
-	if len(m.table.SelectedRow()) > 0 {
-		for _, datum := range m.filteredData {
-			if m.formatFunc(datum)[0] == m.table.SelectedRow()[0] {
-				return m.selectFunc(datum)
-			}
+// autoSelectCmd runs selectFunc against the loaded item m.autoSelect
+// uniquely matches, if one was configured via WithAutoSelect.
+func (m *TableModel[T]) autoSelectCmd() tea.Cmd {
+	if m.autoSelect == nil {
+		return nil
+	}
+
+	var matched []T
+	for _, item := range m.data {
+		if m.autoSelect(item) {
+			matched = append(matched, item)
 		}
 	}
+	if len(matched) == 1 {
+		return m.selectFunc(matched[0])
+	}
+	return nil
+}
+
+func (m *TableModel[T]) selectCurrentRow() tea.Cmd {
+	if datum, ok := m.currentRow(); ok {
+		return m.selectFunc(datum)
+	}
 	return nil
 }
 
-func (m *TableModel[T]) filterData(query string) []T {
+// currentRow resolves the table's cursor position to the underlying datum
+// in m.filteredData. Indexing by position rather than matching rendered row
+// text matters because updateTableRows wraps fuzzy-matched runs in
+// highlightRow, which injects ANSI codes into the rendered cells that
+// formatFunc's plain output never contains.
+func (m *TableModel[T]) currentRow() (T, bool) {
+	i := m.table.Cursor()
+	if i < 0 || i >= len(m.filteredData) {
+		var zero T
+		return zero, false
+	}
+	return m.filteredData[i], true
+}
+
+// filterData ranks m.data against query using m.matcher, returning the
+// matched items in score order alongside the rune offsets to highlight in
+// each one's rendered row.
+func (m *TableModel[T]) filterData(query string) ([]T, [][]int) {
 	if query == "" {
-		return m.data
+		return m.data, nil
 	}
-	var filtered []T
-	for _, item := range m.data {
-		if m.filterFunc(item, strings.ToLower(query)) {
-			filtered = append(filtered, item)
-		}
+
+	matches := m.matcher(m.data, query, m.label)
+	filtered := make([]T, len(matches))
+	highlights := make([][]int, len(matches))
+	for i, match := range matches {
+		filtered[i] = match.Item
+		highlights[i] = match.Indexes
 	}
-	return filtered
+	return filtered, highlights
 }
 
 func (m *TableModel[T]) View() string {
+	if m.loadErr != nil {
+		return style.Render(m.loadErr.Error())
+	}
 	if m.loading {
 		return fmt.Sprintf("\n\n   %s Loading %s...\n\n", m.spinner.View(), m.name)
 	}
@@ -251,15 +476,25 @@ func (m *TableModel[T]) View() string {
 	if m.searching {
 		view.WriteString(fmt.Sprintf("Search: %s\n", m.searchInput.View()))
 	} else {
-		view.WriteString(m.renderActions())
+		view.WriteString(m.help.View(tableHelp[T]{KeyMap: m.keyMap, customOptions: m.customOptions}))
+		if m.streaming {
+			view.WriteString("\n")
+			view.WriteString(m.actionStyle.Render(m.progressText()))
+		} else if m.lastDiff.changed() {
+			view.WriteString("\n")
+			view.WriteString(m.actionStyle.Render(fmt.Sprintf("+%d -%d since last refresh", m.lastDiff.Added, m.lastDiff.Removed)))
+		}
 	}
 
 	return view.String()
 }
 
-func (m *TableModel[T]) renderActions() string {
-	actions := []string{
-		m.actionStyle.Render("/ Search"),
+// progressText renders the footer shown while more pages are still loading,
+// e.g. "Loaded 120/500..." once a total is known, or "Loaded 120..." while
+// it isn't yet.
+func (m *TableModel[T]) progressText() string {
+	if m.total > 0 {
+		return fmt.Sprintf("Loaded %d/%d...", m.loaded, m.total)
 	}
-	return strings.Join(actions, "  ")
+	return fmt.Sprintf("Loaded %d...", m.loaded)
 }
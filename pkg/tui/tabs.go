@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Tab is a single pane hosted by a TabbedModel.
+type Tab struct {
+	Title string
+
+	// New builds the tab's model. It's deferred until the tab is first
+	// focused so switching tabs doesn't do setup work (e.g. reissuing the
+	// API auth chain) for tabs the user never visits.
+	New func() tea.Model
+
+	model       tea.Model
+	initialized bool
+}
+
+// TabbedModel hosts multiple tea.Models as switchable tabs behind a
+// persistent header, so related views (services, deploys, jobs,
+// environments) can share one command instead of one-command-per-model.
+// Tabs switch on 1..9, tab, and shift+tab, and lazy-load on first focus.
+type TabbedModel struct {
+	tabs   []Tab
+	active int
+
+	headerStyle      lipgloss.Style
+	activeTabStyle   lipgloss.Style
+	inactiveTabStyle lipgloss.Style
+}
+
+func NewTabbedModel(tabs ...Tab) *TabbedModel {
+	return &TabbedModel{
+		tabs:             tabs,
+		headerStyle:      lipgloss.NewStyle().Bold(true).PaddingBottom(1),
+		activeTabStyle:   lipgloss.NewStyle().Bold(true).Underline(true).Foreground(lipgloss.Color("205")),
+		inactiveTabStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("241")),
+	}
+}
+
+func (m *TabbedModel) Init() tea.Cmd {
+	return m.focusActive()
+}
+
+// focusActive lazily constructs the active tab's model the first time it's
+// focused, then forwards Init to it.
+func (m *TabbedModel) focusActive() tea.Cmd {
+	tab := &m.tabs[m.active]
+	if tab.initialized {
+		return nil
+	}
+	tab.model = tab.New()
+	tab.initialized = true
+	return tab.model.Init()
+}
+
+func (m *TabbedModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if cmd, handled := m.handleTabKey(keyMsg); handled {
+			return m, cmd
+		}
+		return m, m.updateActive(keyMsg)
+	}
+
+	// Non-key messages are broadcast to every initialized tab, not just the
+	// active one. A tab's own PageLoader keeps streaming pageMsg/pagesDoneMsg
+	// into whichever tab is active at the time they arrive; without this, a
+	// tab the user switched away from mid-load never sees its own messages
+	// again, never re-arms waitForPage, and is stuck on "Loading…" for good.
+	// Each tab's Update already ignores message types it doesn't own, so
+	// broadcasting is harmless for the rest.
+	var cmds []tea.Cmd
+	for i := range m.tabs {
+		tab := &m.tabs[i]
+		if !tab.initialized {
+			continue
+		}
+		var cmd tea.Cmd
+		tab.model, cmd = tab.model.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// updateActive forwards msg to the active tab only, used for key presses
+// that aren't tab-switch keys: those shouldn't be delivered to a tab the
+// user isn't looking at.
+func (m *TabbedModel) updateActive(msg tea.Msg) tea.Cmd {
+	active := &m.tabs[m.active]
+	if active.model == nil {
+		return nil
+	}
+	var cmd tea.Cmd
+	active.model, cmd = active.model.Update(msg)
+	return cmd
+}
+
+func (m *TabbedModel) handleTabKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "tab":
+		return m.setActive((m.active + 1) % len(m.tabs)), true
+	case "shift+tab":
+		return m.setActive((m.active - 1 + len(m.tabs)) % len(m.tabs)), true
+	}
+
+	if n, ok := digitKey(msg.String()); ok && n <= len(m.tabs) {
+		return m.setActive(n - 1), true
+	}
+
+	return nil, false
+}
+
+func digitKey(s string) (int, bool) {
+	if len(s) != 1 || s[0] < '1' || s[0] > '9' {
+		return 0, false
+	}
+	return int(s[0] - '0'), true
+}
+
+func (m *TabbedModel) setActive(i int) tea.Cmd {
+	if i == m.active {
+		return nil
+	}
+	m.active = i
+	return m.focusActive()
+}
+
+func (m *TabbedModel) View() string {
+	var b strings.Builder
+	b.WriteString(m.headerStyle.Render(m.renderHeader()))
+	b.WriteString("\n")
+	if tab := m.tabs[m.active]; tab.model != nil {
+		b.WriteString(tab.model.View())
+	}
+	return b.String()
+}
+
+// Close releases resources (e.g. file watchers) held by any tab that was
+// focused during this session.
+func (m *TabbedModel) Close() {
+	for _, tab := range m.tabs {
+		if tab.initialized {
+			if closer, ok := tab.model.(interface{ Close() }); ok {
+				closer.Close()
+			}
+		}
+	}
+}
+
+func (m *TabbedModel) renderHeader() string {
+	titles := make([]string, len(m.tabs))
+	for i, tab := range m.tabs {
+		title := fmt.Sprintf("%d %s", i+1, tab.Title)
+		if i == m.active {
+			titles[i] = m.activeTabStyle.Render(title)
+		} else {
+			titles[i] = m.inactiveTabStyle.Render(title)
+		}
+	}
+	return strings.Join(titles, "  ")
+}
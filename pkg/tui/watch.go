@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+)
+
+// fileChangedMsg is emitted when a watched path is created, written to, or
+// renamed.
+type fileChangedMsg struct {
+	path string
+}
+
+// watchErrMsg surfaces a failure setting up or reading from the fsnotify
+// watcher. It's non-fatal: the table keeps working, it just stops
+// auto-refreshing.
+type watchErrMsg error
+
+// WithWatchPaths registers paths (files or directories) whose changes on
+// disk trigger an automatic reload via loadFunc, without the user pressing a
+// key. A typical use is a local render.yaml backing the table's data.
+func WithWatchPaths[T any](paths ...string) TableModelOption[T] {
+	return func(m *TableModel[T]) {
+		m.watchPaths = append(m.watchPaths, paths...)
+	}
+}
+
+// startWatching watches each watch path's parent directory rather than the
+// path itself: editors commonly save atomically (write a temp file, then
+// rename it over the target), which replaces the inode fsnotify would
+// otherwise have watched and silently stops future events. Watching the
+// directory and filtering by name in waitForFileChange survives that.
+func (m *TableModel[T]) startWatching() tea.Cmd {
+	if len(m.watchPaths) == 0 {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return func() tea.Msg { return watchErrMsg(err) }
+	}
+
+	dirs := make(map[string]bool, len(m.watchPaths))
+	for _, path := range m.watchPaths {
+		dirs[filepath.Dir(path)] = true
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return func() tea.Msg { return watchErrMsg(err) }
+		}
+	}
+
+	m.watcher = watcher
+	return m.waitForFileChange
+}
+
+// waitForFileChange blocks until the next fsnotify event for one of
+// m.watchPaths and returns it as a tea.Msg. Update re-issues this command
+// after every event so the table keeps watching for as long as it's alive.
+func (m *TableModel[T]) waitForFileChange() tea.Msg {
+	watched := make(map[string]bool, len(m.watchPaths))
+	for _, path := range m.watchPaths {
+		watched[filepath.Clean(path)] = true
+	}
+
+	for {
+		select {
+		case event, ok := <-m.watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			if !watched[filepath.Clean(event.Name)] {
+				continue
+			}
+			return fileChangedMsg{path: event.Name}
+		case err, ok := <-m.watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return watchErrMsg(err)
+		}
+	}
+}
+
+// rowDiff summarizes how a reloaded data set differs from the one it
+// replaced, keyed on each item's rendered label.
+type rowDiff struct {
+	Added   int
+	Removed int
+}
+
+func (d rowDiff) changed() bool {
+	return d.Added > 0 || d.Removed > 0
+}
+
+func diffRows[T any](old, new []T, label func(T) string) rowDiff {
+	oldSet := make(map[string]bool, len(old))
+	for _, item := range old {
+		oldSet[label(item)] = true
+	}
+	newSet := make(map[string]bool, len(new))
+	for _, item := range new {
+		newSet[label(item)] = true
+	}
+
+	var d rowDiff
+	for k := range newSet {
+		if !oldSet[k] {
+			d.Added++
+		}
+	}
+	for k := range oldSet {
+		if !newSet[k] {
+			d.Removed++
+		}
+	}
+	return d
+}